@@ -17,20 +17,19 @@ package cloud
 import (
 	"context"
 	"errors"
+	"fmt"
+	"iter"
 	"net/url"
-	"path"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/tools/clientcmd/api"
 
 	sdkerrs "github.com/upbound/up-sdk-go/errors"
 	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/configurations"
 	"github.com/upbound/up-sdk-go/service/controlplanes"
 	"github.com/upbound/up/internal/controlplane"
-	"github.com/upbound/up/internal/kube"
 )
 
 const (
@@ -48,6 +47,12 @@ type cfgGetter interface {
 	Get(ctx context.Context, account, name string) (*configurations.ConfigurationResponse, error)
 }
 
+// GroupMapper resolves a logical Space "group" (surfaced to callers as
+// ctp.Namespace) to the Upbound account that backs it. ok is false when the
+// group is unknown, in which case callers get an error rather than being
+// silently routed to the Client's default account.
+type GroupMapper func(group string) (account string, ok bool)
+
 type Option func(*Client)
 
 func WithToken(t string) Option {
@@ -62,6 +67,16 @@ func WithProxyEndpoint(p *url.URL) Option {
 	}
 }
 
+// WithGroupMapping configures the Client to treat ctp.Namespace as a
+// logical group, resolved to an Upbound account via m. Without this
+// option, any non-empty namespace is rejected since Upbound Cloud control
+// planes are not namespaced.
+func WithGroupMapping(m GroupMapper) Option {
+	return func(c *Client) {
+		c.groups = m
+	}
+}
+
 // Client is the client used for interacting with the ControlPlanes API in
 // Upbound Cloud.
 type Client struct {
@@ -74,6 +89,12 @@ type Client struct {
 	token string
 	// Proxy Endppint corresponding to Upbound Cloud's Proxy.
 	proxy *url.URL
+	// groups resolves a logical group (ctp.Namespace) to the Upbound
+	// account that backs it. When nil, namespaces are rejected outright.
+	groups GroupMapper
+	// connector manages the App Cluster connector lifecycle. When nil, the
+	// *Connector methods return an error.
+	connector connector
 }
 
 // New instantiates a new Client.
@@ -90,12 +111,33 @@ func New(ctp ctpClient, cfg cfgGetter, account string, opts ...Option) *Client {
 	return c
 }
 
+// resolveAccount maps a ctp.Namespace to the Upbound account that should be
+// used to reach it. An empty group always resolves to the Client's default
+// account. A non-empty group requires a GroupMapper to have been configured
+// via WithGroupMapping; an unrecognized group is an error, since silently
+// falling back to the default account would let a caller asking for one
+// group see another's Control Planes.
+func (c *Client) resolveAccount(group string) (string, error) {
+	if group == "" {
+		return c.account, nil
+	}
+	if c.groups == nil {
+		return "", errors.New("namespace is not supported for Upbound Cloud control planes")
+	}
+	account, ok := c.groups(group)
+	if !ok {
+		return "", fmt.Errorf("unknown group %q", group)
+	}
+	return account, nil
+}
+
 // Get the ControlPlane corresponding to the given ControlPlane name.
 func (c *Client) Get(ctx context.Context, ctp types.NamespacedName) (*controlplane.Response, error) {
-	if ctp.Namespace != "" {
-		return nil, errors.New("namespace is not supported for Upbound Cloud control planes")
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return nil, err
 	}
-	resp, err := c.ctp.Get(ctx, c.account, ctp.Name)
+	resp, err := c.ctp.Get(ctx, account, ctp.Name)
 
 	if sdkerrs.IsNotFound(err) {
 		return nil, controlplane.NewNotFound(err)
@@ -108,27 +150,109 @@ func (c *Client) Get(ctx context.Context, ctp types.NamespacedName) (*controlpla
 	return convert(resp), nil
 }
 
-// List all ControlPlanes within the Upbound Cloud account.
+// ListOptions filters and paginates a ListWithOptions/ListPaged call.
+type ListOptions struct {
+	// PageSize controls how many ControlPlanes are requested per
+	// underlying API call. Defaults to maxItems when unset.
+	PageSize int
+	// MaxResults caps the total number of ControlPlanes returned across
+	// all pages. Zero means unbounded.
+	MaxResults int
+	// ConfigurationName, if set, filters results to ControlPlanes built
+	// from the named Configuration.
+	ConfigurationName string
+	// Status, if set, filters results to ControlPlanes in the given
+	// status.
+	Status controlplanes.Status
+}
+
+// List all ControlPlanes within the Upbound Cloud account, or group when
+// group mapping is configured. Callers that want to stream results as they
+// arrive, or bound how many are fetched, should use ListPaged instead.
 func (c *Client) List(ctx context.Context, namespace string) ([]*controlplane.Response, error) {
-	if namespace != "" {
-		return nil, errors.New("namespace is not supported for Upbound Cloud control planes")
+	return c.ListWithOptions(ctx, namespace, ListOptions{})
+}
+
+// ListWithOptions is List with filtering and pagination control.
+func (c *Client) ListWithOptions(ctx context.Context, namespace string, opts ListOptions) ([]*controlplane.Response, error) {
+	resps := []*controlplane.Response{}
+	for resp, err := range c.ListPaged(ctx, namespace, opts) {
+		if err != nil {
+			return nil, err
+		}
+		resps = append(resps, resp)
 	}
-	l, err := c.ctp.List(ctx, c.account, common.WithSize(maxItems))
-	if err != nil {
-		return nil, err
+	return resps, nil
+}
+
+// ListPaged returns an iterator over the ControlPlanes in namespace.
+//
+// The underlying ControlPlanes List API takes a size limit but no cursor
+// or offset to resume a prior call, so there is no page to fetch beyond
+// the first: ListPaged requests opts.PageSize ControlPlanes once and
+// yields them as opts.ConfigurationName/opts.Status filter and
+// opts.MaxResults cap them. It returns an iterator rather than a slice so
+// that, if the API gains real pagination, callers can stream results
+// without a signature change.
+func (c *Client) ListPaged(ctx context.Context, namespace string, opts ListOptions) iter.Seq2[*controlplane.Response, error] {
+	return func(yield func(*controlplane.Response, error) bool) {
+		account, err := c.resolveAccount(namespace)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		pageSize := opts.PageSize
+		if pageSize <= 0 {
+			pageSize = maxItems
+		}
+
+		l, err := c.ctp.List(ctx, account, common.WithSize(pageSize))
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		yielded := 0
+		for _, r := range l.ControlPlanes {
+			cp := r
+			if opts.ConfigurationName != "" && (cp.ControlPlane.Configuration == nil || cp.ControlPlane.Configuration.Name == nil || *cp.ControlPlane.Configuration.Name != opts.ConfigurationName) {
+				continue
+			}
+			if opts.Status != "" && cp.Status != opts.Status {
+				continue
+			}
+			if !yield(convert(&cp), nil) {
+				return
+			}
+			yielded++
+			if opts.MaxResults > 0 && yielded >= opts.MaxResults {
+				return
+			}
+		}
 	}
+}
+
+// ListGroups lists ControlPlanes across every group supplied, fanning the
+// call out to each group's resolved account. It is intended for callers
+// that want ctp list across "all groups" in a single call.
+func (c *Client) ListGroups(ctx context.Context, groups []string) ([]*controlplane.Response, error) {
 	resps := []*controlplane.Response{}
-	for _, r := range l.ControlPlanes {
-		cp := r
-		resps = append(resps, convert(&cp))
+	for _, g := range groups {
+		gr, err := c.List(ctx, g)
+		if err != nil {
+			return nil, err
+		}
+		resps = append(resps, gr...)
 	}
 	return resps, nil
 }
 
 // Create a new ControlPlane with the given name and the supplied Options.
 func (c *Client) Create(ctx context.Context, ctp types.NamespacedName, opts controlplane.Options) (*controlplane.Response, error) {
-	if ctp.Namespace != "" {
-		return nil, errors.New("namespace is not supported for Upbound Cloud control planes")
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return nil, err
 	}
 	params := &controlplanes.ControlPlaneCreateParameters{
 		Name:        ctp.Name,
@@ -136,14 +260,14 @@ func (c *Client) Create(ctx context.Context, ctp types.NamespacedName, opts cont
 	}
 	if opts.ConfigurationName != nil {
 		// Get the UUID from the Configuration name, if it exists.
-		cfg, err := c.cfg.Get(ctx, c.account, *opts.ConfigurationName)
+		cfg, err := c.cfg.Get(ctx, account, *opts.ConfigurationName)
 		if err != nil {
 			return nil, err
 		}
 		params.ConfigurationID = &cfg.ID
 	}
 
-	resp, err := c.ctp.Create(ctx, c.account, params)
+	resp, err := c.ctp.Create(ctx, account, params)
 	if err != nil {
 		return nil, err
 	}
@@ -153,26 +277,17 @@ func (c *Client) Create(ctx context.Context, ctp types.NamespacedName, opts cont
 
 // Delete the ControlPlane corresponding to the given ControlPlane name.
 func (c *Client) Delete(ctx context.Context, ctp types.NamespacedName) error {
-	if ctp.Namespace != "" {
-		return errors.New("namespace is not supported for Upbound Cloud control planes")
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return err
 	}
-	err := c.ctp.Delete(ctx, c.account, ctp.Name)
+	err = c.ctp.Delete(ctx, account, ctp.Name)
 	if sdkerrs.IsNotFound(err) {
 		return controlplane.NewNotFound(err)
 	}
 	return err
 }
 
-// GetKubeConfig for the given Control Plane.
-func (c *Client) GetKubeConfig(ctx context.Context, ctp types.NamespacedName) (*api.Config, error) {
-	return kube.BuildControlPlaneKubeconfig(
-		c.proxy,
-		path.Join(c.account, ctp.Name),
-		c.token,
-		false,
-	), nil
-}
-
 func convert(ctp *controlplanes.ControlPlaneResponse) *controlplane.Response {
 	var cfgName string
 	var cfgStatus controlplanes.ConfigurationStatus
@@ -0,0 +1,240 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+)
+
+func ctpResponse(name string, status controlplanes.Status, cfgName string) controlplanes.ControlPlaneResponse {
+	r := controlplanes.ControlPlaneResponse{
+		ControlPlane: controlplanes.ControlPlane{Name: name},
+		Status:       status,
+	}
+	if cfgName != "" {
+		r.ControlPlane.Configuration = &controlplanes.ConfigurationReference{Name: &cfgName}
+	}
+	return r
+}
+
+func TestResolveAccount(t *testing.T) {
+	mapper := GroupMapper(func(group string) (string, bool) {
+		if group == "team-a" {
+			return "acme-team-a", true
+		}
+		return "", false
+	})
+
+	cases := map[string]struct {
+		group   string
+		mapping GroupMapper
+		want    string
+		wantErr bool
+	}{
+		"EmptyGroupDefaultsToAccount": {
+			group: "",
+			want:  "acme",
+		},
+		"EmptyGroupDefaultsToAccountEvenWithMapping": {
+			group:   "",
+			mapping: mapper,
+			want:    "acme",
+		},
+		"NoMappingConfigured": {
+			group:   "team-a",
+			wantErr: true,
+		},
+		"KnownGroupResolves": {
+			group:   "team-a",
+			mapping: mapper,
+			want:    "acme-team-a",
+		},
+		"UnknownGroupErrors": {
+			group:   "typo-team",
+			mapping: mapper,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var opts []Option
+			if tc.mapping != nil {
+				opts = append(opts, WithGroupMapping(tc.mapping))
+			}
+			c := New(nil, nil, "acme", opts...)
+
+			got, err := c.resolveAccount(tc.group)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("resolveAccount() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("resolveAccount() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListWithOptions(t *testing.T) {
+	all := []controlplanes.ControlPlaneResponse{
+		ctpResponse("ctp1", controlplanes.StatusReady, "cfg-a"),
+		ctpResponse("ctp2", controlplanes.StatusProvisioning, "cfg-b"),
+		ctpResponse("ctp3", controlplanes.StatusReady, ""),
+	}
+
+	newClient := func() *Client {
+		ctp := &fakeCtpClient{
+			list: func(_ context.Context, account string, _ ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+				if account != "acme" {
+					return nil, errors.New("unexpected account: " + account)
+				}
+				return &controlplanes.ControlPlaneListResponse{ControlPlanes: all}, nil
+			},
+		}
+		return New(ctp, nil, "acme")
+	}
+
+	cases := map[string]struct {
+		opts      ListOptions
+		wantNames []string
+	}{
+		"NoFilter": {
+			wantNames: []string{"ctp1", "ctp2", "ctp3"},
+		},
+		"FilterByConfigurationNameExcludesNilConfiguration": {
+			opts:      ListOptions{ConfigurationName: "cfg-a"},
+			wantNames: []string{"ctp1"},
+		},
+		"FilterByStatus": {
+			opts:      ListOptions{Status: controlplanes.StatusReady},
+			wantNames: []string{"ctp1", "ctp3"},
+		},
+		"MaxResultsCaps": {
+			opts:      ListOptions{MaxResults: 2},
+			wantNames: []string{"ctp1", "ctp2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newClient()
+			got, err := c.ListWithOptions(context.Background(), "", tc.opts)
+			if err != nil {
+				t.Fatalf("ListWithOptions() error = %v", err)
+			}
+			if len(got) != len(tc.wantNames) {
+				t.Fatalf("ListWithOptions() returned %d results, want %d", len(got), len(tc.wantNames))
+			}
+			for i, want := range tc.wantNames {
+				if got[i].Name != want {
+					t.Errorf("result[%d].Name = %q, want %q", i, got[i].Name, want)
+				}
+			}
+		})
+	}
+}
+
+func TestListPagedStopsWhenConsumerReturnsFalse(t *testing.T) {
+	ctp := &fakeCtpClient{
+		list: func(context.Context, string, ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+			return &controlplanes.ControlPlaneListResponse{ControlPlanes: []controlplanes.ControlPlaneResponse{
+				ctpResponse("ctp1", controlplanes.StatusReady, ""),
+				ctpResponse("ctp2", controlplanes.StatusReady, ""),
+			}}, nil
+		},
+	}
+	c := New(ctp, nil, "acme")
+
+	var seen []string
+	for resp, err := range c.ListPaged(context.Background(), "", ListOptions{}) {
+		if err != nil {
+			t.Fatalf("ListPaged() error = %v", err)
+		}
+		seen = append(seen, resp.Name)
+		break
+	}
+
+	if len(seen) != 1 || seen[0] != "ctp1" {
+		t.Fatalf("ListPaged() yielded %v, want to stop after the first result", seen)
+	}
+}
+
+func TestListWithOptionsPropagatesResolveAccountError(t *testing.T) {
+	c := New(nil, nil, "acme")
+
+	if _, err := c.ListWithOptions(context.Background(), "unknown-group", ListOptions{}); err == nil {
+		t.Fatal("expected error when namespace requires an unconfigured GroupMapper, got nil")
+	}
+}
+
+func TestListWithOptionsPropagatesListError(t *testing.T) {
+	wantErr := errors.New("boom")
+	ctp := &fakeCtpClient{
+		list: func(context.Context, string, ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+			return nil, wantErr
+		},
+	}
+	c := New(ctp, nil, "acme")
+
+	if _, err := c.ListWithOptions(context.Background(), "", ListOptions{}); !errors.Is(err, wantErr) {
+		t.Fatalf("ListWithOptions() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestListGroups(t *testing.T) {
+	ctp := &fakeCtpClient{
+		list: func(_ context.Context, account string, _ ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+			return &controlplanes.ControlPlaneListResponse{ControlPlanes: []controlplanes.ControlPlaneResponse{
+				ctpResponse(account+"-ctp", controlplanes.StatusReady, ""),
+			}}, nil
+		},
+	}
+	c := New(ctp, nil, "acme", WithGroupMapping(func(group string) (string, bool) {
+		return "account-" + group, true
+	}))
+
+	got, err := c.ListGroups(context.Background(), []string{"team-a", "team-b"})
+	if err != nil {
+		t.Fatalf("ListGroups() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListGroups() returned %d results, want 2", len(got))
+	}
+	if got[0].Name != "account-team-a-ctp" || got[1].Name != "account-team-b-ctp" {
+		t.Errorf("ListGroups() = %+v, want one result per group, in order", got)
+	}
+}
+
+func TestListGroupsPropagatesErrorFromOneGroup(t *testing.T) {
+	wantErr := errors.New("boom")
+	ctp := &fakeCtpClient{
+		list: func(_ context.Context, account string, _ ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+			if account == "acme" {
+				return nil, wantErr
+			}
+			return &controlplanes.ControlPlaneListResponse{}, nil
+		},
+	}
+	c := New(ctp, nil, "acme")
+
+	if _, err := c.ListGroups(context.Background(), []string{"", ""}); !errors.Is(err, wantErr) {
+		t.Fatalf("ListGroups() error = %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// connector installs, removes and reports on the App Cluster connector for a
+// Control Plane. It is satisfied by the Helm-backed implementation used by
+// the connector CLI commands, and by fakes in tests.
+type connector interface {
+	Install(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) (*ConnectorStatus, error)
+	Uninstall(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) error
+	Status(ctx context.Context, account, token string, ctp types.NamespacedName) (*ConnectorStatus, error)
+}
+
+// ConnectorOptions configures installation of the App Cluster connector.
+type ConnectorOptions struct {
+	// Namespace in the App Cluster to install the connector into.
+	Namespace string
+	// ClusterName is a human readable name for the App Cluster, recorded
+	// against the Control Plane so it can be identified in the UI.
+	ClusterName string
+	// HelmValues are raw Helm value overrides applied on top of the
+	// connector chart's defaults, e.g. "image.tag=v1.2.3".
+	HelmValues []string
+}
+
+// ConnectorStatus reports the state of an installed connector.
+type ConnectorStatus struct {
+	// Installed is true if a connector is currently installed in the
+	// target App Cluster.
+	Installed bool
+	// Version of the connector that is installed.
+	Version string
+	// ControlPlane the connector is wired to.
+	ControlPlane types.NamespacedName
+	// LastHeartbeat is the time the connector last reported itself healthy,
+	// nil if it has never reported in.
+	LastHeartbeat *time.Time
+}
+
+// WithConnector configures the connector implementation used by
+// InstallConnector, UninstallConnector and ConnectorStatus. Without this
+// option those methods return an error.
+func WithConnector(conn connector) Option {
+	return func(c *Client) {
+		c.connector = conn
+	}
+}
+
+// InstallConnector installs the App Cluster connector, wiring it to ctp.
+func (c *Client) InstallConnector(ctx context.Context, ctp types.NamespacedName, opts ConnectorOptions) (*ConnectorStatus, error) {
+	if c.connector == nil {
+		return nil, errors.New("connector support is not configured for this client")
+	}
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return c.connector.Install(ctx, account, c.token, ctp, opts)
+}
+
+// UninstallConnector removes the App Cluster connector previously wired to
+// ctp.
+func (c *Client) UninstallConnector(ctx context.Context, ctp types.NamespacedName, opts ConnectorOptions) error {
+	if c.connector == nil {
+		return errors.New("connector support is not configured for this client")
+	}
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return err
+	}
+	return c.connector.Uninstall(ctx, account, c.token, ctp, opts)
+}
+
+// ConnectorStatus reports the current state of the App Cluster connector
+// wired to ctp.
+func (c *Client) ConnectorStatus(ctx context.Context, ctp types.NamespacedName) (*ConnectorStatus, error) {
+	if c.connector == nil {
+		return nil, errors.New("connector support is not configured for this client")
+	}
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return c.connector.Status(ctx, account, c.token, ctp)
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type fakeConnector struct {
+	install   func(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) (*ConnectorStatus, error)
+	uninstall func(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) error
+	status    func(ctx context.Context, account, token string, ctp types.NamespacedName) (*ConnectorStatus, error)
+}
+
+func (f *fakeConnector) Install(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) (*ConnectorStatus, error) {
+	return f.install(ctx, account, token, ctp, opts)
+}
+
+func (f *fakeConnector) Uninstall(ctx context.Context, account, token string, ctp types.NamespacedName, opts ConnectorOptions) error {
+	return f.uninstall(ctx, account, token, ctp, opts)
+}
+
+func (f *fakeConnector) Status(ctx context.Context, account, token string, ctp types.NamespacedName) (*ConnectorStatus, error) {
+	return f.status(ctx, account, token, ctp)
+}
+
+func TestInstallConnectorNoOption(t *testing.T) {
+	c := New(nil, nil, "acme")
+	if _, err := c.InstallConnector(context.Background(), types.NamespacedName{Name: "ctp1"}, ConnectorOptions{}); err == nil {
+		t.Fatal("expected error when no connector is configured, got nil")
+	}
+}
+
+func TestInstallConnector(t *testing.T) {
+	var gotAccount, gotToken string
+	var gotCtp types.NamespacedName
+
+	conn := &fakeConnector{
+		install: func(_ context.Context, account, token string, ctp types.NamespacedName, _ ConnectorOptions) (*ConnectorStatus, error) {
+			gotAccount, gotToken, gotCtp = account, token, ctp
+			return &ConnectorStatus{Installed: true, Version: "v1.0.0", ControlPlane: ctp}, nil
+		},
+	}
+
+	c := New(nil, nil, "acme", WithToken("shh"), WithConnector(conn))
+
+	status, err := c.InstallConnector(context.Background(), types.NamespacedName{Name: "ctp1"}, ConnectorOptions{ClusterName: "app-cluster"})
+	if err != nil {
+		t.Fatalf("InstallConnector returned unexpected error: %v", err)
+	}
+	if !status.Installed {
+		t.Errorf("status.Installed = false, want true")
+	}
+	if gotAccount != "acme" {
+		t.Errorf("account = %q, want %q", gotAccount, "acme")
+	}
+	if gotToken != "shh" {
+		t.Errorf("token = %q, want %q", gotToken, "shh")
+	}
+	if gotCtp.Name != "ctp1" {
+		t.Errorf("ctp.Name = %q, want %q", gotCtp.Name, "ctp1")
+	}
+}
+
+func TestUninstallConnectorPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	conn := &fakeConnector{
+		uninstall: func(context.Context, string, string, types.NamespacedName, ConnectorOptions) error {
+			return wantErr
+		},
+	}
+
+	c := New(nil, nil, "acme", WithConnector(conn))
+
+	err := c.UninstallConnector(context.Background(), types.NamespacedName{Name: "ctp1"}, ConnectorOptions{})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("UninstallConnector() error = %v, want %v", err, wantErr)
+	}
+}
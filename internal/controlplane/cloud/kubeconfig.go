@@ -0,0 +1,179 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/upbound/up/internal/kube"
+)
+
+// KubeconfigOptions configures GetKubeConfigWithOptions.
+type KubeconfigOptions struct {
+	// ContextName to use for the generated context. Defaults to the
+	// proxy path when empty: ProxyPathOverride if set, else account/name.
+	ContextName string
+	// ClusterName to use for the generated cluster entry. Defaults to
+	// ContextName when empty.
+	ClusterName string
+	// UserName to use for the generated user entry. Defaults to
+	// ContextName when empty.
+	UserName string
+	// MergeInto, if set, receives the generated cluster/user/context
+	// instead of a fresh *api.Config being returned.
+	MergeInto *api.Config
+	// SetCurrent sets the generated context as MergeInto's current
+	// context. Ignored when MergeInto is nil.
+	SetCurrent bool
+	// Force overwrites a cluster, user or context already present in
+	// MergeInto under the generated name. Without Force, any such existing
+	// entry is left untouched and the generated one is dropped. Ignored
+	// when MergeInto is nil.
+	Force bool
+	// ProxyPathOverride overrides the Upbound proxy path segment used to
+	// build the cluster's server URL. Defaults to account/ctp.Name.
+	ProxyPathOverride string
+}
+
+// GetKubeConfig for the given Control Plane.
+func (c *Client) GetKubeConfig(ctx context.Context, ctp types.NamespacedName) (*api.Config, error) {
+	return c.GetKubeConfigWithOptions(ctx, ctp, KubeconfigOptions{})
+}
+
+// GetKubeConfigWithOptions is GetKubeConfig with control over the generated
+// context/cluster/user names and, optionally, merging the result into an
+// existing kubeconfig rather than returning a standalone one.
+func (c *Client) GetKubeConfigWithOptions(ctx context.Context, ctp types.NamespacedName, opts KubeconfigOptions) (*api.Config, error) {
+	account, err := c.resolveAccount(ctp.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyPath := opts.ProxyPathOverride
+	if proxyPath == "" {
+		proxyPath = path.Join(account, ctp.Name)
+	}
+
+	cfg := kube.BuildControlPlaneKubeconfig(c.proxy, proxyPath, c.token, false)
+
+	ctxName := opts.ContextName
+	if ctxName == "" {
+		ctxName = proxyPath
+	}
+	clusterName := opts.ClusterName
+	if clusterName == "" {
+		clusterName = ctxName
+	}
+	userName := opts.UserName
+	if userName == "" {
+		userName = ctxName
+	}
+
+	renameContext(cfg, ctxName, clusterName, userName)
+
+	if opts.MergeInto == nil {
+		return cfg, nil
+	}
+
+	mergeKubeconfig(opts.MergeInto, cfg, ctxName, opts.Force)
+	if opts.SetCurrent {
+		opts.MergeInto.CurrentContext = ctxName
+	}
+	return opts.MergeInto, nil
+}
+
+// renameContext rewrites cfg in place so its single generated
+// cluster/user/context are keyed by clusterName/userName/ctxName instead
+// of whatever default name kube.BuildControlPlaneKubeconfig used.
+func renameContext(cfg *api.Config, ctxName, clusterName, userName string) {
+	oldCtx := cfg.Contexts[cfg.CurrentContext]
+
+	c := cfg.Clusters[oldCtx.Cluster]
+	u := cfg.AuthInfos[oldCtx.AuthInfo]
+
+	oldCtx.Cluster = clusterName
+	oldCtx.AuthInfo = userName
+
+	cfg.Clusters = map[string]*api.Cluster{clusterName: c}
+	cfg.AuthInfos = map[string]*api.AuthInfo{userName: u}
+	cfg.Contexts = map[string]*api.Context{ctxName: oldCtx}
+	cfg.CurrentContext = ctxName
+}
+
+// mergeKubeconfig merges the context named name from src, together with the
+// cluster and user that context references, into dst. An existing entry
+// under the same name in dst is left untouched unless force is set, in
+// which case it's replaced. Entries under other names already present in
+// dst are always left untouched.
+func mergeKubeconfig(dst, src *api.Config, name string, force bool) {
+	if dst.Clusters == nil {
+		dst.Clusters = map[string]*api.Cluster{}
+	}
+	if dst.AuthInfos == nil {
+		dst.AuthInfos = map[string]*api.AuthInfo{}
+	}
+	if dst.Contexts == nil {
+		dst.Contexts = map[string]*api.Context{}
+	}
+
+	srcCtx := src.Contexts[name]
+	if _, exists := dst.Clusters[srcCtx.Cluster]; force || !exists {
+		dst.Clusters[srcCtx.Cluster] = src.Clusters[srcCtx.Cluster]
+	}
+	if _, exists := dst.AuthInfos[srcCtx.AuthInfo]; force || !exists {
+		dst.AuthInfos[srcCtx.AuthInfo] = src.AuthInfos[srcCtx.AuthInfo]
+	}
+	if _, exists := dst.Contexts[name]; force || !exists {
+		dst.Contexts[name] = srcCtx
+	}
+}
+
+// ResolveContextControlPlane reverse-resolves which Control Plane a
+// kubeconfig context refers to, by extracting the Upbound proxy path
+// segment embedded in its cluster's server URL. The path is expected to be
+// account/ctp.Name (see GetKubeConfigWithOptions): the second-to-last path
+// segment is returned as Namespace and the last as Name. Namespace holds
+// the account, not a group; Get/List/Create/Delete read a non-empty
+// Namespace as a group to be resolved through the GroupMapper. ok is false
+// if ctxName or its cluster is unknown, or the server URL's path has fewer
+// than two segments; the server's host is not checked.
+func ResolveContextControlPlane(cfg *api.Config, ctxName string) (ctp types.NamespacedName, ok bool) {
+	context, found := cfg.Contexts[ctxName]
+	if !found {
+		return types.NamespacedName{}, false
+	}
+	cluster, found := cfg.Clusters[context.Cluster]
+	if !found {
+		return types.NamespacedName{}, false
+	}
+
+	u, err := url.Parse(cluster.Server)
+	if err != nil {
+		return types.NamespacedName{}, false
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-1] == "" {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: parts[len(parts)-2], Name: parts[len(parts)-1]}, true
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestResolveContextControlPlane(t *testing.T) {
+	cfg := &api.Config{
+		Clusters: map[string]*api.Cluster{
+			"acme/my-ctp": {Server: "https://proxy.upbound.io/acme/my-ctp"},
+		},
+		Contexts: map[string]*api.Context{
+			"acme/my-ctp": {Cluster: "acme/my-ctp", AuthInfo: "acme/my-ctp"},
+		},
+	}
+
+	cases := map[string]struct {
+		ctxName string
+		want    types.NamespacedName
+		wantOK  bool
+	}{
+		"Known": {
+			ctxName: "acme/my-ctp",
+			want:    types.NamespacedName{Namespace: "acme", Name: "my-ctp"},
+			wantOK:  true,
+		},
+		"UnknownContext": {
+			ctxName: "does-not-exist",
+			wantOK:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := ResolveContextControlPlane(cfg, tc.ctxName)
+			if ok != tc.wantOK {
+				t.Fatalf("ResolveContextControlPlane() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if ok && got != tc.want {
+				t.Errorf("ResolveContextControlPlane() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRenameContext(t *testing.T) {
+	cfg := &api.Config{
+		Clusters:       map[string]*api.Cluster{"default-cluster": {Server: "https://proxy.upbound.io/acme/my-ctp"}},
+		AuthInfos:      map[string]*api.AuthInfo{"default-user": {Token: "shh"}},
+		Contexts:       map[string]*api.Context{"default-context": {Cluster: "default-cluster", AuthInfo: "default-user"}},
+		CurrentContext: "default-context",
+	}
+
+	renameContext(cfg, "acme/my-ctp", "my-cluster", "my-user")
+
+	if cfg.CurrentContext != "acme/my-ctp" {
+		t.Errorf("CurrentContext = %q, want %q", cfg.CurrentContext, "acme/my-ctp")
+	}
+	if _, ok := cfg.Clusters["my-cluster"]; !ok {
+		t.Error("Clusters missing renamed entry \"my-cluster\"")
+	}
+	if _, ok := cfg.AuthInfos["my-user"]; !ok {
+		t.Error("AuthInfos missing renamed entry \"my-user\"")
+	}
+	ctx, ok := cfg.Contexts["acme/my-ctp"]
+	if !ok {
+		t.Fatal("Contexts missing renamed entry \"acme/my-ctp\"")
+	}
+	if ctx.Cluster != "my-cluster" || ctx.AuthInfo != "my-user" {
+		t.Errorf("renamed context = %+v, want Cluster=my-cluster AuthInfo=my-user", ctx)
+	}
+	if len(cfg.Clusters) != 1 || len(cfg.AuthInfos) != 1 || len(cfg.Contexts) != 1 {
+		t.Errorf("renameContext left stale entries behind: %+v", cfg)
+	}
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	newSrc := func() *api.Config {
+		return &api.Config{
+			Clusters:  map[string]*api.Cluster{"acme/my-ctp": {Server: "https://proxy.upbound.io/acme/my-ctp"}},
+			AuthInfos: map[string]*api.AuthInfo{"acme/my-ctp": {Token: "new"}},
+			Contexts:  map[string]*api.Context{"acme/my-ctp": {Cluster: "acme/my-ctp", AuthInfo: "acme/my-ctp"}},
+		}
+	}
+
+	t.Run("NoExistingEntry", func(t *testing.T) {
+		dst := &api.Config{}
+		mergeKubeconfig(dst, newSrc(), "acme/my-ctp", false)
+
+		if dst.AuthInfos["acme/my-ctp"].Token != "new" {
+			t.Errorf("AuthInfos[...].Token = %q, want %q", dst.AuthInfos["acme/my-ctp"].Token, "new")
+		}
+	})
+
+	t.Run("ExistingEntryNotOverwrittenWithoutForce", func(t *testing.T) {
+		dst := &api.Config{
+			Clusters:  map[string]*api.Cluster{"acme/my-ctp": {Server: "https://old"}},
+			AuthInfos: map[string]*api.AuthInfo{"acme/my-ctp": {Token: "old"}},
+			Contexts:  map[string]*api.Context{"acme/my-ctp": {Cluster: "acme/my-ctp", AuthInfo: "acme/my-ctp"}},
+		}
+		mergeKubeconfig(dst, newSrc(), "acme/my-ctp", false)
+
+		if dst.AuthInfos["acme/my-ctp"].Token != "old" {
+			t.Errorf("AuthInfos[...].Token = %q, want unchanged %q", dst.AuthInfos["acme/my-ctp"].Token, "old")
+		}
+		if dst.Clusters["acme/my-ctp"].Server != "https://old" {
+			t.Errorf("Clusters[...].Server = %q, want unchanged %q", dst.Clusters["acme/my-ctp"].Server, "https://old")
+		}
+	})
+
+	t.Run("ExistingEntryOverwrittenWithForce", func(t *testing.T) {
+		dst := &api.Config{
+			Clusters:  map[string]*api.Cluster{"acme/my-ctp": {Server: "https://old"}},
+			AuthInfos: map[string]*api.AuthInfo{"acme/my-ctp": {Token: "old"}},
+			Contexts:  map[string]*api.Context{"acme/my-ctp": {Cluster: "acme/my-ctp", AuthInfo: "acme/my-ctp"}},
+		}
+		mergeKubeconfig(dst, newSrc(), "acme/my-ctp", true)
+
+		if dst.AuthInfos["acme/my-ctp"].Token != "new" {
+			t.Errorf("AuthInfos[...].Token = %q, want %q", dst.AuthInfos["acme/my-ctp"].Token, "new")
+		}
+	})
+
+	t.Run("OtherEntriesUntouched", func(t *testing.T) {
+		dst := &api.Config{
+			Clusters:  map[string]*api.Cluster{"other": {Server: "https://other"}},
+			AuthInfos: map[string]*api.AuthInfo{"other": {Token: "other"}},
+			Contexts:  map[string]*api.Context{"other": {Cluster: "other", AuthInfo: "other"}},
+		}
+		mergeKubeconfig(dst, newSrc(), "acme/my-ctp", false)
+
+		if _, ok := dst.Clusters["other"]; !ok {
+			t.Error("merge removed unrelated Clusters entry \"other\"")
+		}
+		if _, ok := dst.Clusters["acme/my-ctp"]; !ok {
+			t.Error("merge did not add \"acme/my-ctp\"")
+		}
+	})
+}
+
+func TestGetKubeConfigWithOptionsMerge(t *testing.T) {
+	existing := &api.Config{
+		Clusters:  map[string]*api.Cluster{"acme/my-ctp": {Server: "https://stale"}},
+		AuthInfos: map[string]*api.AuthInfo{"acme/my-ctp": {Token: "stale"}},
+		Contexts:  map[string]*api.Context{"acme/my-ctp": {Cluster: "acme/my-ctp", AuthInfo: "acme/my-ctp"}},
+	}
+
+	c := New(nil, nil, "acme", WithToken("shh"))
+
+	got, err := c.GetKubeConfigWithOptions(context.Background(), types.NamespacedName{Name: "my-ctp"}, KubeconfigOptions{
+		MergeInto:  existing,
+		SetCurrent: true,
+	})
+	if err != nil {
+		t.Fatalf("GetKubeConfigWithOptions() error = %v", err)
+	}
+	if got != existing {
+		t.Fatal("GetKubeConfigWithOptions() did not return MergeInto when set")
+	}
+	if got.CurrentContext != "acme/my-ctp" {
+		t.Errorf("CurrentContext = %q, want %q", got.CurrentContext, "acme/my-ctp")
+	}
+	if got.AuthInfos["acme/my-ctp"].Token != "stale" {
+		t.Errorf("AuthInfos[...].Token = %q, want unchanged %q (no Force requested)", got.AuthInfos["acme/my-ctp"].Token, "stale")
+	}
+}
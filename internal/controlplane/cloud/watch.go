@@ -0,0 +1,119 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/upbound/up/internal/controlplane"
+)
+
+// defaultWatchInterval is how often Watch polls Get when WatchOptions.Interval
+// is left unset.
+const defaultWatchInterval = 5 * time.Second
+
+// WatchOptions configures a Watch call.
+type WatchOptions struct {
+	// Interval between polls of the Control Plane's state. Defaults to
+	// defaultWatchInterval when zero.
+	Interval time.Duration
+}
+
+// Watch polls ctp on an interval and emits an event each time its observed
+// state changes, approximating "kubectl get -w" for backends that don't
+// support server-side watch. The returned channel is closed when ctx is
+// done, the Control Plane is observed to have been deleted, or Get fails
+// with anything other than a not-found error — in the last case a final
+// Error event carrying the cause is sent before the channel closes.
+func (c *Client) Watch(ctx context.Context, ctp types.NamespacedName) (<-chan controlplane.Event, error) {
+	return c.WatchWithOptions(ctx, ctp, WatchOptions{})
+}
+
+// WatchWithOptions is Watch with a configurable poll interval.
+func (c *Client) WatchWithOptions(ctx context.Context, ctp types.NamespacedName, opts WatchOptions) (<-chan controlplane.Event, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	events := make(chan controlplane.Event)
+	go func() {
+		defer close(events)
+
+		var prev *controlplane.Response
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			curr, err := c.Get(ctx, ctp)
+			if err != nil && !controlplane.IsNotFound(err) {
+				select {
+				case events <- controlplane.Event{Type: controlplane.Error, Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			if controlplane.IsNotFound(err) {
+				curr = nil
+			}
+
+			if evt, ok := diffEvent(prev, curr); ok {
+				select {
+				case events <- evt:
+				case <-ctx.Done():
+					return
+				}
+			}
+			prev = curr
+
+			if curr == nil {
+				// The Control Plane is gone; nothing left to watch.
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// diffEvent compares the previously and currently observed state of a
+// Control Plane and reports the Event that should be emitted, if any.
+// Age is intentionally excluded from the comparison since it changes on
+// every poll.
+func diffEvent(prev, curr *controlplane.Response) (controlplane.Event, bool) {
+	switch {
+	case prev == nil && curr != nil:
+		return controlplane.Event{Type: controlplane.Added, ControlPlane: curr}, true
+	case prev != nil && curr == nil:
+		return controlplane.Event{Type: controlplane.Deleted, ControlPlane: prev}, true
+	case prev != nil && curr != nil:
+		if prev.Ready != curr.Ready || prev.Message != curr.Message || prev.Updated != curr.Updated {
+			return controlplane.Event{Type: controlplane.StatusChanged, ControlPlane: curr}, true
+		}
+		if prev.Cfg != curr.Cfg || prev.Synced != curr.Synced || prev.Name != curr.Name {
+			return controlplane.Event{Type: controlplane.Modified, ControlPlane: curr}, true
+		}
+	}
+	return controlplane.Event{}, false
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+	"github.com/upbound/up/internal/controlplane"
+)
+
+func TestDiffEvent(t *testing.T) {
+	ready := &controlplane.Response{Name: "ctp1", Ready: "True", Message: "", Updated: "True"}
+	notReady := &controlplane.Response{Name: "ctp1", Ready: "False", Message: "Controlplane is being updated", Updated: "True"}
+	renamedCfg := &controlplane.Response{Name: "ctp1", Ready: "True", Message: "", Updated: "True", Cfg: "new-cfg"}
+
+	cases := map[string]struct {
+		prev, curr *controlplane.Response
+		wantOK     bool
+		wantType   controlplane.EventType
+	}{
+		"FirstObservation": {
+			prev:     nil,
+			curr:     ready,
+			wantOK:   true,
+			wantType: controlplane.Added,
+		},
+		"Deleted": {
+			prev:     ready,
+			curr:     nil,
+			wantOK:   true,
+			wantType: controlplane.Deleted,
+		},
+		"StatusChanged": {
+			prev:     ready,
+			curr:     notReady,
+			wantOK:   true,
+			wantType: controlplane.StatusChanged,
+		},
+		"Modified": {
+			prev:     ready,
+			curr:     renamedCfg,
+			wantOK:   true,
+			wantType: controlplane.Modified,
+		},
+		"Unchanged": {
+			prev:   ready,
+			curr:   ready,
+			wantOK: false,
+		},
+		"BothNil": {
+			prev:   nil,
+			curr:   nil,
+			wantOK: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			evt, ok := diffEvent(tc.prev, tc.curr)
+			if ok != tc.wantOK {
+				t.Fatalf("diffEvent() ok = %v, want %v", ok, tc.wantOK)
+			}
+			if ok && evt.Type != tc.wantType {
+				t.Errorf("diffEvent() Type = %v, want %v", evt.Type, tc.wantType)
+			}
+		})
+	}
+}
+
+type fakeCtpClient struct {
+	get  func(ctx context.Context, account, name string) (*controlplanes.ControlPlaneResponse, error)
+	list func(ctx context.Context, account string, opts ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error)
+}
+
+func (f *fakeCtpClient) Create(context.Context, string, *controlplanes.ControlPlaneCreateParameters) (*controlplanes.ControlPlaneResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeCtpClient) Delete(context.Context, string, string) error {
+	return errors.New("not implemented")
+}
+
+func (f *fakeCtpClient) Get(ctx context.Context, account, name string) (*controlplanes.ControlPlaneResponse, error) {
+	return f.get(ctx, account, name)
+}
+
+func (f *fakeCtpClient) List(ctx context.Context, account string, opts ...common.ListOption) (*controlplanes.ControlPlaneListResponse, error) {
+	if f.list == nil {
+		return nil, errors.New("not implemented")
+	}
+	return f.list(ctx, account, opts...)
+}
+
+func TestWatchWithOptionsSurfacesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	ctp := &fakeCtpClient{
+		get: func(context.Context, string, string) (*controlplanes.ControlPlaneResponse, error) {
+			return nil, wantErr
+		},
+	}
+
+	c := New(ctp, nil, "acme")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := c.WatchWithOptions(ctx, types.NamespacedName{Name: "ctp1"}, WatchOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WatchWithOptions() error = %v", err)
+	}
+
+	evt, ok := <-events
+	if !ok {
+		t.Fatal("expected an Error event, channel closed with no event")
+	}
+	if evt.Type != controlplane.Error {
+		t.Fatalf("evt.Type = %v, want %v", evt.Type, controlplane.Error)
+	}
+	if !errors.Is(evt.Err, wantErr) {
+		t.Errorf("evt.Err = %v, want %v", evt.Err, wantErr)
+	}
+
+	if _, ok := <-events; ok {
+		t.Error("expected channel to be closed after the Error event")
+	}
+}
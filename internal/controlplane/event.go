@@ -0,0 +1,45 @@
+// Copyright 2023 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+// EventType describes the kind of change a Watch observed.
+type EventType string
+
+const (
+	// Added indicates the Control Plane was observed for the first time.
+	Added EventType = "ADDED"
+	// Modified indicates a non-status field of the Control Plane changed.
+	Modified EventType = "MODIFIED"
+	// Deleted indicates the Control Plane no longer exists.
+	Deleted EventType = "DELETED"
+	// StatusChanged indicates the Control Plane's readiness or Configuration
+	// status changed.
+	StatusChanged EventType = "STATUS_CHANGED"
+	// Error indicates the Watch failed to observe the Control Plane's state
+	// and has stopped; Err holds the cause.
+	Error EventType = "ERROR"
+)
+
+// Event is emitted by a Watch when a Control Plane's observed state changes
+// between polls.
+type Event struct {
+	Type EventType
+	// ControlPlane is the most recently observed Response. For a Deleted
+	// event this is the last known state prior to deletion. Unset for an
+	// Error event.
+	ControlPlane *Response
+	// Err is set when Type is Error and holds the cause. Nil otherwise.
+	Err error
+}
@@ -16,19 +16,27 @@ package dep
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/crossplane/crossplane/apis/pkg/v1beta1"
 )
 
 const (
-	packageTagFmt = "%s:%s"
+	packageTagFmt    = "%s:%s"
+	packageDigestFmt = "%s@%s"
 )
 
+// digestRef matches the "<algo>:<hex>" part that follows the "@" of an OCI
+// digest reference, e.g. "sha256:abcd...".
+var digestRef = regexp.MustCompile(`^[a-z0-9]+:[a-f0-9]{32,}$`)
+
 // New returns a new v1beta1.Dependency based on the given package name
 // and PackageType (reprented as a string).
 // Expects names of the form source@version where @version can be
-// left blank in order to indicate 'latest'.
+// left blank in order to indicate 'latest'. @version may also be a
+// digest pin of the form @sha256:<digest>, in which case it is preserved
+// verbatim as the constraint rather than being defaulted.
 func New(pkg, t string) v1beta1.Dependency {
 	// if the passed in ver was blank use the default to pass
 	// constraint checks and grab latest semver
@@ -47,17 +55,31 @@ func New(pkg, t string) v1beta1.Dependency {
 		Constraints: version,
 	}
 
-	if strings.Title(strings.ToLower(t)) == string(v1beta1.ConfigurationPackageType) {
+	switch strings.Title(strings.ToLower(t)) {
+	case string(v1beta1.ConfigurationPackageType):
 		d.Type = v1beta1.ConfigurationPackageType
+	case string(v1beta1.FunctionPackageType):
+		d.Type = v1beta1.FunctionPackageType
 	}
 
 	return d
 }
 
-// ImgTag returns the full image tag "source:version" of the given dependency
+// isDigest returns true if the given constraint is an OCI digest pin, e.g.
+// "sha256:abcd...", rather than a semver range.
+func isDigest(constraints string) bool {
+	return digestRef.MatchString(constraints)
+}
+
+// ImgTag returns the full image reference of the given dependency, either as
+// "source:version" for a semver constraint or "source@sha256:<digest>" when
+// the dependency is pinned to a digest.
 func ImgTag(d v1beta1.Dependency) string {
 	// NOTE(@tnthornton) this should ONLY be used after the version constraint
 	// has been resolved for the given dependency. Using a semver range is not
 	// a valid tag format and will cause lookups to this string to fail.
+	if isDigest(d.Constraints) {
+		return fmt.Sprintf(packageDigestFmt, d.Identifier(), d.Constraints)
+	}
 	return fmt.Sprintf(packageTagFmt, d.Identifier(), d.Constraints)
 }
@@ -0,0 +1,141 @@
+// Copyright 2021 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dep
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane/apis/pkg/v1beta1"
+)
+
+func TestNew(t *testing.T) {
+	type args struct {
+		pkg string
+		t   string
+	}
+	cases := map[string]struct {
+		args args
+		want v1beta1.Dependency
+	}{
+		"ProviderDefaultVersion": {
+			args: args{
+				pkg: "crossplane/provider-aws",
+				t:   "provider",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/provider-aws",
+				Type:        v1beta1.ProviderPackageType,
+				Constraints: defaultVer,
+			},
+		},
+		"ProviderWithVersion": {
+			args: args{
+				pkg: "crossplane/provider-aws@v0.20.0",
+				t:   "provider",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/provider-aws",
+				Type:        v1beta1.ProviderPackageType,
+				Constraints: "v0.20.0",
+			},
+		},
+		"Configuration": {
+			args: args{
+				pkg: "crossplane/getting-started-aws@v0.20.0",
+				t:   "configuration",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/getting-started-aws",
+				Type:        v1beta1.ConfigurationPackageType,
+				Constraints: "v0.20.0",
+			},
+		},
+		"Function": {
+			args: args{
+				pkg: "crossplane/function-patch-and-transform@v0.1.0",
+				t:   "function",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/function-patch-and-transform",
+				Type:        v1beta1.FunctionPackageType,
+				Constraints: "v0.1.0",
+			},
+		},
+		"ProviderWithDigest": {
+			args: args{
+				pkg: "crossplane/provider-aws@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+				t:   "provider",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/provider-aws",
+				Type:        v1beta1.ProviderPackageType,
+				Constraints: "sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+			},
+		},
+		"FunctionWithDigest": {
+			args: args{
+				pkg: "crossplane/function-patch-and-transform@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+				t:   "function",
+			},
+			want: v1beta1.Dependency{
+				Package:     "crossplane/function-patch-and-transform",
+				Type:        v1beta1.FunctionPackageType,
+				Constraints: "sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := New(tc.args.pkg, tc.args.t)
+			if got != tc.want {
+				t.Errorf("New(%q, %q) = %+v, want %+v", tc.args.pkg, tc.args.t, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestImgTag(t *testing.T) {
+	cases := map[string]struct {
+		dep  v1beta1.Dependency
+		want string
+	}{
+		"SemverConstraint": {
+			dep: v1beta1.Dependency{
+				Package:     "crossplane/provider-aws",
+				Type:        v1beta1.ProviderPackageType,
+				Constraints: "v0.20.0",
+			},
+			want: "crossplane/provider-aws:v0.20.0",
+		},
+		"DigestConstraint": {
+			dep: v1beta1.Dependency{
+				Package:     "crossplane/provider-aws",
+				Type:        v1beta1.ProviderPackageType,
+				Constraints: "sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+			},
+			want: "crossplane/provider-aws@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ImgTag(tc.dep)
+			if got != tc.want {
+				t.Errorf("ImgTag(%+v) = %q, want %q", tc.dep, got, tc.want)
+			}
+		})
+	}
+}